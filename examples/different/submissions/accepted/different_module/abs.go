@@ -0,0 +1,9 @@
+package main
+
+func abs(x int64) int64 {
+    if x < 0 {
+        return -x
+    } else {
+        return x
+    }
+}
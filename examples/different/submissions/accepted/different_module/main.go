@@ -0,0 +1,19 @@
+package main
+
+import (
+    "fmt"
+    "io"
+)
+
+func main() {
+    var a, b int64
+
+    for {
+        _, err := fmt.Scanf("%d%d", &a, &b)
+        if err == io.EOF {
+            break
+        }
+
+        fmt.Printf("%d\n", abs(a-b))
+    }
+}